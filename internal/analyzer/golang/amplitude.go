@@ -0,0 +1,246 @@
+package golang
+
+import "go/ast"
+
+// amplitudeIdentifyOps maps the amplitude.Identify builder's operator
+// methods onto the operator tag recorded on each property they touch.
+var amplitudeIdentifyOps = map[string]string{
+	"Set":     "set",
+	"SetOnce": "set_once",
+	"Add":     "add",
+	"Append":  "append",
+	"Prepend": "prepend",
+	"Unset":   "unset",
+}
+
+// AnalyzeAmplitude walks file looking for Amplitude SDK calls: client.Track,
+// client.Identify (built via the amplitude.Identify{} operator chain),
+// client.GroupIdentify, and client.Revenue.
+func AnalyzeAmplitude(file *ast.File) []Event {
+	var events []Event
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		events = append(events, analyzeAmplitudeFunc(fn)...)
+	}
+	return events
+}
+
+// analyzeAmplitudeFunc makes a single ordered pass over fn's body, since an
+// amplitude.Identify{} variable can be reused across several client.Identify
+// calls with more operator calls applied between them — each call must see
+// only the properties accumulated on the variable up to that point, not the
+// variable's fully-accumulated final state.
+func analyzeAmplitudeFunc(fn *ast.FuncDecl) []Event {
+	clientVars := map[string]bool{}
+	identifyVars := map[string]bool{}
+	identifyProps := map[string]map[string]Property{}
+	var events []Event
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		if assign, ok := n.(*ast.AssignStmt); ok && len(assign.Lhs) == 1 && len(assign.Rhs) == 1 {
+			if lhs, ok := identifierName(assign.Lhs[0]); ok {
+				switch rhs := assign.Rhs[0].(type) {
+				case *ast.CompositeLit:
+					if isAmplitudeTypeLiteral(rhs, "Identify") {
+						identifyVars[lhs] = true
+						identifyProps[lhs] = map[string]Property{}
+					}
+				case *ast.CallExpr:
+					if x, sel, ok := selector(rhs.Fun); ok && sel == "NewClient" {
+						if pkg, ok := identifierName(x); ok && pkg == "amplitude" {
+							clientVars[lhs] = true
+						}
+					}
+				}
+			}
+		}
+
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		recvExpr, method, ok := selector(call.Fun)
+		if !ok {
+			return true
+		}
+		recv, ok := identifierName(recvExpr)
+		if !ok {
+			return true
+		}
+		if identifyVars[recv] {
+			if op, ok := amplitudeIdentifyOps[method]; ok && len(call.Args) > 0 {
+				if key, ok := stringLiteral(call.Args[0]); ok {
+					prop := Property{Op: op, Type: "unknown"}
+					if len(call.Args) > 1 {
+						prop.Type = inferPropertyType(call.Args[1])
+					}
+					identifyProps[recv][key] = prop
+				}
+			}
+		}
+
+		if !clientVars[recv] {
+			return true
+		}
+
+		switch method {
+		case "Track":
+			if len(call.Args) != 1 {
+				return true
+			}
+			if ev, ok := amplitudeTrackEvent(call.Args[0]); ok {
+				events = append(events, ev)
+			}
+		case "Identify":
+			if len(call.Args) != 2 {
+				return true
+			}
+			if name, ok := identifierName(call.Args[0]); ok && identifyVars[name] {
+				events = append(events, Event{
+					Provider:   "amplitude",
+					Kind:       "identify",
+					EventName:  "$identify",
+					Properties: snapshotProps(identifyProps[name]),
+					Identity:   amplitudeIdentityFromOptions(call.Args[1]),
+				})
+			}
+		case "GroupIdentify":
+			if len(call.Args) != 4 {
+				return true
+			}
+			events = append(events, amplitudeGroupIdentifyEvent(call.Args, identifyVars, identifyProps))
+		case "Revenue":
+			if len(call.Args) != 2 {
+				return true
+			}
+			if ev, ok := amplitudeRevenueEvent(call.Args[0]); ok {
+				ev.Identity = amplitudeIdentityFromOptions(call.Args[1])
+				events = append(events, ev)
+			}
+		}
+		return true
+	})
+	return events
+}
+
+// isAmplitudeTypeLiteral reports whether lit is a composite literal of the
+// form amplitude.<typeName>{...}.
+func isAmplitudeTypeLiteral(lit *ast.CompositeLit, typeName string) bool {
+	sel, ok := lit.Type.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != typeName {
+		return false
+	}
+	pkg, ok := identifierName(sel.X)
+	return ok && pkg == "amplitude"
+}
+
+// snapshotProps copies props so later mutations to the source map (e.g. more
+// operator calls on a reused amplitude.Identify{} variable) don't retroactively
+// change an already-emitted event.
+func snapshotProps(props map[string]Property) map[string]Property {
+	if len(props) == 0 {
+		return nil
+	}
+	out := make(map[string]Property, len(props))
+	for k, v := range props {
+		out[k] = v
+	}
+	return out
+}
+
+// amplitudeIdentityFromOptions classifies an amplitude.EventOptions{...}
+// literal as identified when it sets a non-empty UserID, anonymous when it
+// sets only a DeviceID.
+func amplitudeIdentityFromOptions(expr ast.Expr) string {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return ""
+	}
+	if userIDVal, ok := structFieldValue(lit, "UserID"); ok && !isEmptyStringLiteral(userIDVal) {
+		return IdentityIdentified
+	}
+	if _, ok := structFieldValue(lit, "DeviceID"); ok {
+		return IdentityAnonymous
+	}
+	return ""
+}
+
+func amplitudeTrackEvent(expr ast.Expr) (Event, bool) {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok || !isAmplitudeTypeLiteral(lit, "Event") {
+		return Event{}, false
+	}
+	nameVal, ok := structFieldValue(lit, "EventType")
+	if !ok {
+		return Event{}, false
+	}
+	name, ok := stringLiteral(nameVal)
+	if !ok {
+		return Event{}, false
+	}
+	ev := Event{
+		Provider:  "amplitude",
+		Kind:      "track",
+		EventName: name,
+		Identity:  amplitudeIdentityFromOptions(lit),
+	}
+	if propsVal, ok := structFieldValue(lit, "EventProperties"); ok {
+		ev.Properties = propertiesFromMapLiteral(propsVal)
+	}
+	return ev, true
+}
+
+func amplitudeGroupIdentifyEvent(args []ast.Expr, identifyVars map[string]bool, identifyProps map[string]map[string]Property) Event {
+	ev := Event{
+		Provider:   "amplitude",
+		Kind:       "group_identify",
+		EventName:  "$groupidentify",
+		Attributes: map[string]string{},
+		Identity:   amplitudeIdentityFromOptions(args[3]),
+	}
+	if groupType, ok := stringLiteral(args[0]); ok {
+		ev.Attributes["group_type"] = groupType
+	}
+	if groupName, ok := stringLiteral(args[1]); ok {
+		ev.Attributes["group_name"] = groupName
+	}
+	if name, ok := identifierName(args[2]); ok && identifyVars[name] {
+		ev.Properties = snapshotProps(identifyProps[name])
+	}
+	if len(ev.Attributes) == 0 {
+		ev.Attributes = nil
+	}
+	return ev
+}
+
+// amplitudeRevenueEvent handles amplitude.Revenue{ProductID, Price, Quantity, RevenueType, EventProperties}.
+func amplitudeRevenueEvent(expr ast.Expr) (Event, bool) {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok || !isAmplitudeTypeLiteral(lit, "Revenue") {
+		return Event{}, false
+	}
+	ev := Event{
+		Provider:  "amplitude",
+		Kind:      "revenue",
+		EventName: "revenue_amount",
+		Properties: liftFields(lit, map[string]string{
+			"ProductID":   "product_id",
+			"Price":       "price",
+			"Quantity":    "quantity",
+			"RevenueType": "revenue_type",
+		}),
+	}
+	if epVal, ok := structFieldValue(lit, "EventProperties"); ok {
+		if epLit, ok := epVal.(*ast.CompositeLit); ok {
+			if typeVal, ok := structFieldValue(epLit, "EventType"); ok {
+				if name, ok := stringLiteral(typeVal); ok && name != "" {
+					ev.EventName = name
+				}
+			}
+		}
+	}
+	return ev, true
+}
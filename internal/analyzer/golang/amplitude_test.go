@@ -0,0 +1,182 @@
+package golang
+
+import "testing"
+
+const amplitudeSrc = `
+package main
+
+func amplitudeIdentifyTrack(userId string, plan string, groupName string, price float64) {
+	client := amplitude.NewClient(config)
+
+	identify := amplitude.Identify{}
+	identify.Set("plan", "Enterprise")
+	identify.SetOnce("first_seen", "2024-01-01")
+	identify.Add("login_count", 1)
+	identify.Unset("legacy_flag")
+	client.Identify(identify, amplitude.EventOptions{
+		UserID: userId,
+	})
+
+	client.GroupIdentify("org", groupName, amplitude.Identify{}, amplitude.EventOptions{
+		UserID: userId,
+	})
+
+	client.Revenue(amplitude.Revenue{
+		ProductID:   "sku-1",
+		Price:       price,
+		Quantity:    1,
+		RevenueType: "purchase",
+	}, amplitude.EventOptions{
+		UserID: userId,
+	})
+}
+`
+
+func TestAnalyzeAmplitude(t *testing.T) {
+	file := parseFile(t, amplitudeSrc)
+	events := AnalyzeAmplitude(file)
+
+	wantKinds := []string{"identify", "group_identify", "revenue"}
+	if len(events) != len(wantKinds) {
+		t.Fatalf("got %d events, want %d: %+v", len(events), len(wantKinds), events)
+	}
+	for i, k := range wantKinds {
+		if events[i].Kind != k {
+			t.Errorf("event %d kind = %q, want %q", i, events[i].Kind, k)
+		}
+	}
+
+	identify := events[0]
+	if got := identify.Properties["plan"]; got.Op != "set" || got.Type != "string" {
+		t.Errorf("identify plan property = %+v, want op=set type=string", got)
+	}
+	if got := identify.Properties["first_seen"]; got.Op != "set_once" {
+		t.Errorf("identify first_seen property = %+v, want op=set_once", got)
+	}
+	if got := identify.Properties["login_count"]; got.Op != "add" || got.Type != "number" {
+		t.Errorf("identify login_count property = %+v, want op=add type=number", got)
+	}
+	if got := identify.Properties["legacy_flag"]; got.Op != "unset" {
+		t.Errorf("identify legacy_flag property = %+v, want op=unset", got)
+	}
+
+	if got := events[1].Attributes["group_type"]; got != "org" {
+		t.Errorf("group_identify group_type = %q, want org", got)
+	}
+
+	revenue := events[2]
+	if revenue.EventName != "revenue_amount" {
+		t.Errorf("revenue event name = %q, want revenue_amount", revenue.EventName)
+	}
+	if got := revenue.Properties["product_id"]; got.Type != "string" {
+		t.Errorf("revenue product_id property = %+v", got)
+	}
+	if got := revenue.Properties["price"]; got.Type != "unknown" {
+		t.Errorf("revenue price property = %+v, want unknown (variable, not literal)", got)
+	}
+
+	for i, ev := range events {
+		if ev.Identity != IdentityIdentified {
+			t.Errorf("event %d (%s) Identity = %q, want %q (UserID set)", i, ev.Kind, ev.Identity, IdentityIdentified)
+		}
+	}
+}
+
+const amplitudeReusedIdentifySrc = `
+package main
+
+func amplitudeReusedIdentify(userId string) {
+	client := amplitude.NewClient(config)
+	identify := amplitude.Identify{}
+	identify.Set("a", "1")
+	client.Identify(identify, amplitude.EventOptions{UserID: userId})
+	identify.Set("b", "2")
+	client.Identify(identify, amplitude.EventOptions{UserID: userId})
+}
+`
+
+func TestAnalyzeAmplitudeReusedIdentifyVar(t *testing.T) {
+	file := parseFile(t, amplitudeReusedIdentifySrc)
+	events := AnalyzeAmplitude(file)
+
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2: %+v", len(events), events)
+	}
+	if _, ok := events[0].Properties["a"]; !ok {
+		t.Errorf("first identify event missing property a: %+v", events[0])
+	}
+	if _, ok := events[0].Properties["b"]; ok {
+		t.Errorf("first identify event should not see property b set after it, got %+v", events[0])
+	}
+	if _, ok := events[1].Properties["a"]; !ok {
+		t.Errorf("second identify event missing property a: %+v", events[1])
+	}
+	if _, ok := events[1].Properties["b"]; !ok {
+		t.Errorf("second identify event missing property b: %+v", events[1])
+	}
+}
+
+const amplitudeAnonymousTrackSrc = `
+package main
+
+func amplitudeAnonymousTrack(deviceId string) {
+	client := amplitude.NewClient(config)
+	client.Track(amplitude.Event{
+		EventType: "App Opened",
+		DeviceID:  deviceId,
+	})
+}
+`
+
+func TestAnalyzeAmplitudeAnonymousTrack(t *testing.T) {
+	file := parseFile(t, amplitudeAnonymousTrackSrc)
+	events := AnalyzeAmplitude(file)
+
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1: %+v", len(events), events)
+	}
+	if events[0].Identity != IdentityAnonymous {
+		t.Errorf("DeviceID-only track event Identity = %q, want %q", events[0].Identity, IdentityAnonymous)
+	}
+}
+
+const amplitudeUnrelatedRevenueSrc = `
+package main
+
+func ledgerPayout(price float64) {
+	ledger.Revenue(Payout{
+		ProductID: "sku-1",
+		Price:     price,
+	}, opts)
+}
+`
+
+func TestAnalyzeAmplitudeIgnoresUnrelatedRevenueCalls(t *testing.T) {
+	file := parseFile(t, amplitudeUnrelatedRevenueSrc)
+	events := AnalyzeAmplitude(file)
+
+	if len(events) != 0 {
+		t.Fatalf("got %d events from an unrelated ledger.Revenue call, want 0: %+v", len(events), events)
+	}
+}
+
+const amplitudeWrongLiteralRevenueSrc = `
+package main
+
+func wrongLiteralRevenue(price float64) {
+	client := amplitude.NewClient(config)
+	client.Revenue(Payout{
+		ProductID: "sku-1",
+		Price:     price,
+	}, amplitude.EventOptions{})
+}
+`
+
+func TestAnalyzeAmplitudeIgnoresNonRevenueLiteral(t *testing.T) {
+	file := parseFile(t, amplitudeWrongLiteralRevenueSrc)
+	events := AnalyzeAmplitude(file)
+
+	if len(events) != 0 {
+		t.Fatalf("got %d events from a non-amplitude.Revenue literal, want 0: %+v", len(events), events)
+	}
+}
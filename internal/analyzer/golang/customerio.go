@@ -0,0 +1,100 @@
+package golang
+
+import "go/ast"
+
+// AnalyzeCustomerio walks file looking for github.com/customerio/go-customerio
+// calls: Track(customerID, event, data), TrackAnonymous(anonymousID, event,
+// data), and Identify(customerID, attrs). Each detected call is tagged with
+// an Identity classification.
+func AnalyzeCustomerio(file *ast.File) []Event {
+	var events []Event
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		events = append(events, analyzeCustomerioFunc(fn)...)
+	}
+	return events
+}
+
+func analyzeCustomerioFunc(fn *ast.FuncDecl) []Event {
+	clientVars := map[string]bool{}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			return true
+		}
+		lhs, ok := identifierName(assign.Lhs[0])
+		if !ok {
+			return true
+		}
+		call, ok := assign.Rhs[0].(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if x, sel, ok := selector(call.Fun); ok && sel == "NewTrackClient" {
+			if pkg, ok := identifierName(x); ok && pkg == "customerio" {
+				clientVars[lhs] = true
+			}
+		}
+		return true
+	})
+
+	var events []Event
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		recvExpr, method, ok := selector(call.Fun)
+		if !ok {
+			return true
+		}
+		recv, ok := identifierName(recvExpr)
+		if !ok || !clientVars[recv] {
+			return true
+		}
+
+		switch {
+		case method == "Identify" && len(call.Args) == 2:
+			events = append(events, Event{
+				Provider:   "customerio",
+				Kind:       "identify",
+				EventName:  "$identify",
+				Properties: propertiesFromMapLiteral(call.Args[1]),
+				Identity:   IdentityIdentified,
+			})
+		case method == "Track" && len(call.Args) == 3:
+			name, ok := stringLiteral(call.Args[1])
+			if !ok {
+				return true
+			}
+			identity := IdentityIdentified
+			if isEmptyStringLiteral(call.Args[0]) {
+				identity = IdentityAnonymous
+			}
+			events = append(events, Event{
+				Provider:   "customerio",
+				Kind:       "track",
+				EventName:  name,
+				Properties: propertiesFromMapLiteral(call.Args[2]),
+				Identity:   identity,
+			})
+		case method == "TrackAnonymous" && len(call.Args) == 3:
+			name, ok := stringLiteral(call.Args[1])
+			if !ok {
+				return true
+			}
+			events = append(events, Event{
+				Provider:   "customerio",
+				Kind:       "track",
+				EventName:  name,
+				Properties: propertiesFromMapLiteral(call.Args[2]),
+				Identity:   IdentityAnonymous,
+			})
+		}
+		return true
+	})
+	return events
+}
@@ -0,0 +1,46 @@
+package golang
+
+import "testing"
+
+const customerioSrc = `
+package main
+
+import "github.com/customerio/go-customerio"
+
+func customerioTrack(customerID string, anonymousID string, plan string) {
+	client := customerio.NewTrackClient("site", "key")
+	client.Identify(customerID, map[string]any{
+		"plan": plan,
+	})
+	client.Track(customerID, "Upgraded Plan", map[string]any{
+		"plan": plan,
+	})
+	client.TrackAnonymous(anonymousID, "Viewed Pricing Page", map[string]any{
+		"plan": plan,
+	})
+	client.Track("", "Viewed Pricing Page", map[string]any{
+		"plan": plan,
+	})
+}
+`
+
+func TestAnalyzeCustomerio(t *testing.T) {
+	file := parseFile(t, customerioSrc)
+	events := AnalyzeCustomerio(file)
+
+	if len(events) != 4 {
+		t.Fatalf("got %d events, want 4: %+v", len(events), events)
+	}
+	if events[0].Kind != "identify" || events[0].Identity != IdentityIdentified {
+		t.Errorf("identify event = %+v", events[0])
+	}
+	if events[1].EventName != "Upgraded Plan" || events[1].Identity != IdentityIdentified {
+		t.Errorf("track event = %+v", events[1])
+	}
+	if events[2].Identity != IdentityAnonymous {
+		t.Errorf("TrackAnonymous event should be anonymous, got %+v", events[2])
+	}
+	if events[3].Identity != IdentityAnonymous {
+		t.Errorf("Track with empty customerID should be anonymous, got %+v", events[3])
+	}
+}
@@ -0,0 +1,188 @@
+package golang
+
+import (
+	"go/ast"
+	"strings"
+	"unicode"
+)
+
+// ga4EcommerceEvents are the standard GA4 Measurement Protocol v2
+// e-commerce event names this detector special-cases.
+var ga4EcommerceEvents = map[string]bool{
+	"add_to_cart":       true,
+	"add_to_wishlist":   true,
+	"add_payment_info":  true,
+	"add_shipping_info": true,
+	"begin_checkout":    true,
+	"purchase":          true,
+	"refund":            true,
+	"remove_from_cart":  true,
+	"view_item":         true,
+	"select_item":       true,
+}
+
+// ga4TypedEventFields maps the exported fields of the typed GA4 e-commerce
+// helper structs (e.g. event.Purchase) onto normalized property keys.
+var ga4TypedEventFields = map[string]string{
+	"TransactionId": "transaction_id",
+	"Currency":      "currency",
+	"Value":         "value",
+	"Items":         "items",
+}
+
+// AnalyzeGA4 walks file looking for GA4 Measurement Protocol v2 client.Send
+// calls, recognizing both plain measurementprotocol.Event literals and typed
+// e-commerce helper structs (event.Purchase{...}.ToEvent()).
+func AnalyzeGA4(file *ast.File) []Event {
+	var events []Event
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		events = append(events, analyzeGA4Func(fn)...)
+	}
+	return events
+}
+
+func analyzeGA4Func(fn *ast.FuncDecl) []Event {
+	clientVars := map[string]bool{}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			return true
+		}
+		lhs, ok := identifierName(assign.Lhs[0])
+		if !ok {
+			return true
+		}
+		call, ok := assign.Rhs[0].(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if x, sel, ok := selector(call.Fun); ok && sel == "NewClient" {
+			if pkg, ok := identifierName(x); ok && pkg == "measurementprotocol" {
+				clientVars[lhs] = true
+			}
+		}
+		return true
+	})
+
+	var events []Event
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 2 {
+			return true
+		}
+		recvExpr, method, ok := selector(call.Fun)
+		if !ok || method != "Send" {
+			return true
+		}
+		recv, ok := identifierName(recvExpr)
+		if !ok || !clientVars[recv] {
+			return true
+		}
+		if ev, ok := ga4EventFromArg(call.Args[1]); ok {
+			events = append(events, ev)
+		}
+		return true
+	})
+	return events
+}
+
+func ga4EventFromArg(arg ast.Expr) (Event, bool) {
+	switch v := arg.(type) {
+	case *ast.CompositeLit:
+		return ga4EventFromLiteral(v)
+	case *ast.CallExpr:
+		return ga4EventFromToEventCall(v)
+	}
+	return Event{}, false
+}
+
+// ga4EventFromLiteral handles measurementprotocol.Event{Name: "...", Params: map[string]any{...}}.
+func ga4EventFromLiteral(lit *ast.CompositeLit) (Event, bool) {
+	sel, ok := lit.Type.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Event" {
+		return Event{}, false
+	}
+	if pkg, ok := identifierName(sel.X); !ok || pkg != "measurementprotocol" {
+		return Event{}, false
+	}
+	nameVal, ok := structFieldValue(lit, "Name")
+	if !ok {
+		return Event{}, false
+	}
+	name, ok := stringLiteral(nameVal)
+	if !ok {
+		return Event{}, false
+	}
+	ev := Event{Provider: "ga4", Kind: "event", EventName: name}
+	if paramsVal, ok := structFieldValue(lit, "Params"); ok {
+		ev.Properties = propertiesFromMapLiteral(paramsVal)
+	}
+	if ga4EcommerceEvents[name] {
+		ev.Attributes = map[string]string{"ecommerce": "true"}
+	}
+	return ev, true
+}
+
+// ga4EventFromToEventCall handles event.Purchase{Items: []Item{...}, ...}.ToEvent().
+func ga4EventFromToEventCall(call *ast.CallExpr) (Event, bool) {
+	x, method, ok := selector(call.Fun)
+	if !ok || method != "ToEvent" || len(call.Args) != 0 {
+		return Event{}, false
+	}
+	lit, ok := x.(*ast.CompositeLit)
+	if !ok {
+		return Event{}, false
+	}
+	sel, ok := lit.Type.(*ast.SelectorExpr)
+	if !ok {
+		return Event{}, false
+	}
+	if pkg, ok := identifierName(sel.X); !ok || pkg != "event" {
+		return Event{}, false
+	}
+	name := pascalToSnake(sel.Sel.Name)
+	if !ga4EcommerceEvents[name] {
+		return Event{}, false
+	}
+
+	props := map[string]Property{}
+	for field, key := range ga4TypedEventFields {
+		val, ok := structFieldValue(lit, field)
+		if !ok {
+			continue
+		}
+		props[key] = Property{Type: inferPropertyType(val)}
+	}
+	if len(props) == 0 {
+		props = nil
+	}
+
+	return Event{
+		Provider:   "ga4",
+		Kind:       "event",
+		EventName:  name,
+		Properties: props,
+		Attributes: map[string]string{"ecommerce": "true"},
+	}, true
+}
+
+// pascalToSnake converts a Go exported type name like "AddToCart" to its
+// GA4 event-name equivalent "add_to_cart".
+func pascalToSnake(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,102 @@
+package golang
+
+import "testing"
+
+const ga4Src = `
+package main
+
+func ga4Track(clientId string, transactionId string, price float64) {
+	client := measurementprotocol.NewClient("id", "secret")
+	client.Send(clientId, measurementprotocol.Event{
+		Name: "begin_checkout",
+		Params: map[string]any{
+			"currency": "USD",
+			"value":    price,
+		},
+	})
+	client.Send(clientId, event.Purchase{
+		TransactionId: transactionId,
+		Currency:      "USD",
+		Value:         price,
+		Items: []event.Item{
+			{ItemId: "sku-1", ItemName: "Widget", Price: price},
+		},
+	}.ToEvent())
+}
+`
+
+func TestAnalyzeGA4(t *testing.T) {
+	file := parseFile(t, ga4Src)
+	events := AnalyzeGA4(file)
+
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2: %+v", len(events), events)
+	}
+
+	if events[0].EventName != "begin_checkout" || events[0].Provider != "ga4" {
+		t.Errorf("event 0 = %+v", events[0])
+	}
+	if events[0].Attributes["ecommerce"] != "true" {
+		t.Errorf("event 0 should be tagged ecommerce, got %+v", events[0].Attributes)
+	}
+	if got := events[0].Properties["currency"]; got.Type != "string" {
+		t.Errorf("event 0 currency property = %+v", got)
+	}
+
+	if events[1].EventName != "purchase" {
+		t.Errorf("event 1 name = %q, want purchase", events[1].EventName)
+	}
+	if got := events[1].Properties["currency"]; got.Type != "string" {
+		t.Errorf("event 1 currency property = %+v", got)
+	}
+	if got := events[1].Properties["items"]; got.Type != "array" {
+		t.Errorf("event 1 items property = %+v, want array", got)
+	}
+}
+
+const ga4UnrelatedSendSrc = `
+package main
+
+func busTrack(price float64) {
+	bus.Send("topic", Widget{
+		Name: "begin_checkout",
+		Params: map[string]any{
+			"currency": "USD",
+			"value":    price,
+		},
+	})
+}
+`
+
+func TestAnalyzeGA4IgnoresUnrelatedSendCalls(t *testing.T) {
+	file := parseFile(t, ga4UnrelatedSendSrc)
+	events := AnalyzeGA4(file)
+
+	if len(events) != 0 {
+		t.Fatalf("got %d events from an unrelated bus.Send call, want 0: %+v", len(events), events)
+	}
+}
+
+const ga4WrongLiteralTypeSrc = `
+package main
+
+func wrongLiteralTrack(clientId string, price float64) {
+	client := measurementprotocol.NewClient("id", "secret")
+	client.Send(clientId, Widget{
+		Name: "begin_checkout",
+		Params: map[string]any{
+			"currency": "USD",
+			"value":    price,
+		},
+	})
+}
+`
+
+func TestAnalyzeGA4IgnoresNonEventLiteral(t *testing.T) {
+	file := parseFile(t, ga4WrongLiteralTypeSrc)
+	events := AnalyzeGA4(file)
+
+	if len(events) != 0 {
+		t.Fatalf("got %d events from a non-measurementprotocol.Event literal, want 0: %+v", len(events), events)
+	}
+}
@@ -0,0 +1,169 @@
+package golang
+
+import (
+	"fmt"
+	"go/ast"
+)
+
+// mixpanelPeopleOps are the Mixpanel People operation verbs recognized on
+// People.Update calls.
+var mixpanelPeopleOps = map[string]bool{
+	"$set":      true,
+	"$set_once": true,
+	"$add":      true,
+	"$append":   true,
+	"$union":    true,
+	"$remove":   true,
+	"$unset":    true,
+}
+
+// AnalyzeMixpanel walks file looking for Mixpanel SDK calls: client.Track,
+// client.Import, and People-API mutations reached through the
+// client.Identify(id) fluent chain (people.Update/people.Track).
+func AnalyzeMixpanel(file *ast.File) []Event {
+	var events []Event
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		events = append(events, analyzeMixpanelFunc(fn)...)
+	}
+	return events
+}
+
+func analyzeMixpanelFunc(fn *ast.FuncDecl) []Event {
+	clientVars := map[string]bool{}
+	peopleVars := map[string]bool{}
+
+	// First pass: follow `mp := mixpanel.NewApiClient(...)` and
+	// `people := mp.Identify(id)` across local assignments in this function
+	// scope, so later calls on those variables are recognized regardless of
+	// how many statements separate the assignment from the use.
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			return true
+		}
+		lhs, ok := identifierName(assign.Lhs[0])
+		if !ok {
+			return true
+		}
+		call, ok := assign.Rhs[0].(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if x, sel, ok := selector(call.Fun); ok && sel == "NewApiClient" {
+			if pkg, ok := identifierName(x); ok && pkg == "mixpanel" {
+				clientVars[lhs] = true
+				return true
+			}
+		}
+		if x, sel, ok := selector(call.Fun); ok && sel == "Identify" {
+			if recv, ok := identifierName(x); ok && clientVars[recv] {
+				peopleVars[lhs] = true
+			}
+		}
+		return true
+	})
+
+	var events []Event
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		recvExpr, method, ok := selector(call.Fun)
+		if !ok {
+			return true
+		}
+		recv, ok := identifierName(recvExpr)
+		if !ok {
+			return true
+		}
+
+		switch {
+		case clientVars[recv] && method == "Track" && len(call.Args) == 2:
+			events = append(events, mixpanelEventsFromNewEventSlice(call.Args[1], "track")...)
+		case clientVars[recv] && method == "Import" && len(call.Args) == 2:
+			events = append(events, mixpanelEventsFromNewEventSlice(call.Args[1], "import")...)
+		case peopleVars[recv] && method == "Update" && len(call.Args) == 2:
+			if ev, ok := mixpanelPeopleUpdateEvent(call.Args[1]); ok {
+				events = append(events, ev)
+			}
+		case peopleVars[recv] && method == "Track" && len(call.Args) == 3:
+			if ev, ok := mixpanelPeopleTrackEvent(call.Args[1], call.Args[2]); ok {
+				events = append(events, ev)
+			}
+		}
+		return true
+	})
+	return events
+}
+
+// mixpanelEventsFromNewEventSlice handles `[]*mixpanel.Event{mp.NewEvent(name, id, props), ...}`.
+func mixpanelEventsFromNewEventSlice(expr ast.Expr, kind string) []Event {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+	var events []Event
+	for _, elt := range lit.Elts {
+		call, ok := elt.(*ast.CallExpr)
+		if !ok || len(call.Args) != 3 {
+			continue
+		}
+		if _, sel, ok := selector(call.Fun); !ok || sel != "NewEvent" {
+			continue
+		}
+		name, ok := stringLiteral(call.Args[0])
+		if !ok {
+			continue
+		}
+		events = append(events, Event{
+			Provider:   "mixpanel",
+			Kind:       kind,
+			EventName:  name,
+			Properties: propertiesFromMapLiteral(call.Args[2]),
+		})
+	}
+	return events
+}
+
+// mixpanelPeopleUpdateEvent handles `mixpanel.PeopleProperties{"$set": map[string]any{...}}`.
+func mixpanelPeopleUpdateEvent(expr ast.Expr) (Event, bool) {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok || len(lit.Elts) == 0 {
+		return Event{}, false
+	}
+	kv, ok := lit.Elts[0].(*ast.KeyValueExpr)
+	if !ok {
+		return Event{}, false
+	}
+	op, ok := stringLiteral(kv.Key)
+	if !ok || !mixpanelPeopleOps[op] {
+		return Event{}, false
+	}
+	return Event{
+		Provider:   "mixpanel",
+		Kind:       "identify",
+		EventName:  fmt.Sprintf("$identify:%s", op),
+		Properties: propertiesFromMapLiteral(kv.Value),
+		Attributes: map[string]string{"op": op},
+	}, true
+}
+
+// mixpanelPeopleTrackEvent handles `people.Track(ctx, "Upgraded Plan", map[string]any{...})`.
+func mixpanelPeopleTrackEvent(nameExpr, propsExpr ast.Expr) (Event, bool) {
+	name, ok := stringLiteral(nameExpr)
+	if !ok {
+		return Event{}, false
+	}
+	return Event{
+		Provider:   "mixpanel",
+		Kind:       "track",
+		EventName:  name,
+		Properties: propertiesFromMapLiteral(propsExpr),
+		Attributes: map[string]string{"surface": "people"},
+	}, true
+}
@@ -0,0 +1,137 @@
+package golang
+
+import "testing"
+
+const mixpanelSrc = `
+package main
+
+import (
+	"context"
+
+	"github.com/mixpanel/mixpanel-go"
+)
+
+func mixpanelImportTrack(userId string, price float64) {
+	mp := mixpanel.NewApiClient("token")
+	ctx := context.Background()
+	mp.Import(ctx, []*mixpanel.Event{
+		mp.NewEvent("historical_purchase", userId, map[string]any{
+			"plan":  "premium",
+			"price": price,
+		}),
+	})
+}
+
+func mixpanelPeopleTrack(userId string, plan string) {
+	mp := mixpanel.NewApiClient("token")
+	ctx := context.Background()
+	people := mp.Identify(userId)
+	people.Update(ctx, mixpanel.PeopleProperties{
+		"$set": map[string]any{
+			"plan": plan,
+		},
+	})
+	people.Track(ctx, "Upgraded Plan", map[string]any{
+		"plan": plan,
+	})
+}
+`
+
+func TestAnalyzeMixpanel(t *testing.T) {
+	file := parseFile(t, mixpanelSrc)
+	events := AnalyzeMixpanel(file)
+
+	want := []Event{
+		{
+			Provider:  "mixpanel",
+			Kind:      "import",
+			EventName: "historical_purchase",
+			Properties: map[string]Property{
+				"plan":  {Type: "string"},
+				"price": {Type: "unknown"},
+			},
+		},
+		{
+			Provider:  "mixpanel",
+			Kind:      "identify",
+			EventName: "$identify:$set",
+			Properties: map[string]Property{
+				"plan": {Type: "unknown"},
+			},
+			Attributes: map[string]string{"op": "$set"},
+		},
+		{
+			Provider:  "mixpanel",
+			Kind:      "track",
+			EventName: "Upgraded Plan",
+			Properties: map[string]Property{
+				"plan": {Type: "unknown"},
+			},
+			Attributes: map[string]string{"surface": "people"},
+		},
+	}
+
+	if len(events) != len(want) {
+		t.Fatalf("got %d events, want %d: %+v", len(events), len(want), events)
+	}
+	for i, w := range want {
+		got := events[i]
+		if got.Provider != w.Provider || got.Kind != w.Kind || got.EventName != w.EventName {
+			t.Errorf("event %d = %+v, want %+v", i, got, w)
+		}
+		for k, p := range w.Properties {
+			if got.Properties[k] != p {
+				t.Errorf("event %d property %q = %+v, want %+v", i, k, got.Properties[k], p)
+			}
+		}
+		for k, a := range w.Attributes {
+			if got.Attributes[k] != a {
+				t.Errorf("event %d attribute %q = %q, want %q", i, k, got.Attributes[k], a)
+			}
+		}
+	}
+}
+
+const mixpanelTrackSrc = `
+package main
+
+import (
+	"context"
+
+	"github.com/mixpanel/mixpanel-go"
+)
+
+func mixpanelTrack(userId string, price float64) {
+	mp := mixpanel.NewApiClient("token")
+	ctx := context.Background()
+	mp.Track(ctx, []*mixpanel.Event{
+		mp.NewEvent("purchase", userId, map[string]any{
+			"plan":  "premium",
+			"price": price,
+		}),
+	})
+}
+`
+
+func TestAnalyzeMixpanelTrack(t *testing.T) {
+	file := parseFile(t, mixpanelTrackSrc)
+	events := AnalyzeMixpanel(file)
+
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1: %+v", len(events), events)
+	}
+
+	ev := events[0]
+	if ev.Kind != "track" {
+		t.Errorf("kind = %q, want track", ev.Kind)
+	}
+	if ev.EventName != "purchase" {
+		t.Errorf("event name = %q, want purchase", ev.EventName)
+	}
+	if got := ev.Properties["plan"]; got.Type != "string" {
+		t.Errorf("plan property = %+v, want string", got)
+	}
+	if got := ev.Properties["price"]; got.Type != "unknown" {
+		t.Errorf("price property = %+v, want unknown (variable, not literal)", got)
+	}
+}
@@ -0,0 +1,27 @@
+package golang
+
+import "go/ast"
+
+// Providers lists every tracking provider this package can detect, in the
+// order their detectors run when analyzing a file.
+var Providers = []string{
+	"segment",
+	"mixpanel",
+	"snowplow",
+	"ga4",
+	"customerio",
+	"amplitude",
+}
+
+// AnalyzeFile runs every registered provider's detector over file and
+// returns all detected events, in provider-registration order.
+func AnalyzeFile(file *ast.File) []Event {
+	var events []Event
+	events = append(events, AnalyzeSegment(file)...)
+	events = append(events, AnalyzeMixpanel(file)...)
+	events = append(events, AnalyzeSnowplow(file)...)
+	events = append(events, AnalyzeGA4(file)...)
+	events = append(events, AnalyzeCustomerio(file)...)
+	events = append(events, AnalyzeAmplitude(file)...)
+	return events
+}
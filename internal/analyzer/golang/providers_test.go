@@ -0,0 +1,24 @@
+package golang
+
+import "testing"
+
+func TestAnalyzeFileCombinesProviders(t *testing.T) {
+	file := parseFile(t, ga4Src)
+	events := AnalyzeFile(file)
+
+	var sawGA4 bool
+	for _, ev := range events {
+		if ev.Provider == "ga4" {
+			sawGA4 = true
+		}
+	}
+	if !sawGA4 {
+		t.Errorf("AnalyzeFile did not surface any ga4 events: %+v", events)
+	}
+
+	for _, p := range Providers {
+		if p == "" {
+			t.Errorf("Providers contains an empty entry")
+		}
+	}
+}
@@ -0,0 +1,234 @@
+// Package golang implements the tracking-call detectors used to analyze Go
+// source files for analytics provider SDK usage (Segment, Mixpanel,
+// Snowplow, GA4, Customer.io, Amplitude, ...).
+//
+// Each provider's detector walks the parsed AST of a single file looking for
+// calls recognized as emitting a tracking event, and reports them as Events
+// with a normalized property schema so downstream tooling can treat every
+// provider uniformly.
+package golang
+
+import (
+	"go/ast"
+	"strconv"
+	"strings"
+)
+
+// Property describes the inferred type of a single property captured from a
+// tracking call's payload.
+type Property struct {
+	Type string // "string", "number", "boolean", "object", "array", or "unknown"
+	// Op tags the property with the builder operation verb that set it
+	// (e.g. Amplitude Identify's "set"/"add"/"unset"), when a call's
+	// properties can each carry a different mutation operator. Providers
+	// whose op applies to the whole call instead (e.g. Mixpanel People's
+	// Update, which tags Event.Attributes["op"]) leave this empty.
+	Op string
+}
+
+// Event is a single detected tracking call, normalized across providers.
+type Event struct {
+	Provider   string
+	Kind       string // e.g. "track", "identify", "group", "page", "screen", "alias", "revenue"
+	EventName  string
+	Properties map[string]Property
+	// Identity classifies the call as IdentityIdentified or
+	// IdentityAnonymous when a provider-specific detector can tell which
+	// user (if any) the call is attributed to; empty when undetermined.
+	// This is a cross-provider attribute: Segment keys it off
+	// UserId/AnonymousId, Customer.io off Track vs. TrackAnonymous,
+	// Amplitude off a UserID/DeviceID-only event, etc.
+	Identity string
+	// Attributes holds free-form, provider-specific tags (e.g. the Mixpanel
+	// People operation verb) that don't fit the Kind/EventName/Properties
+	// shape.
+	Attributes map[string]string
+}
+
+const (
+	// IdentityIdentified and IdentityAnonymous are the values detectors use
+	// for the cross-provider "identity" attribute, set whenever a call can
+	// be classified as belonging to a known user or an anonymous visitor.
+	IdentityIdentified = "identified"
+	IdentityAnonymous  = "anonymous"
+)
+
+// stringLiteral resolves expr to a string value when it is a plain string
+// literal, or a single-argument wrapper call around one (e.g. Snowplow's
+// sp.NewString("x")). It does not follow variables.
+func stringLiteral(expr ast.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind.String() != "STRING" {
+			return "", false
+		}
+		v, err := strconv.Unquote(e.Value)
+		if err != nil {
+			return "", false
+		}
+		return v, true
+	case *ast.CallExpr:
+		if len(e.Args) != 1 {
+			return "", false
+		}
+		if !isWrapperCall(e, "NewString") {
+			return "", false
+		}
+		return stringLiteral(e.Args[0])
+	}
+	return "", false
+}
+
+// isWrapperCall reports whether call is a single-level helper invocation
+// named name, regardless of package/receiver (e.g. `sp.NewString(...)` or
+// `pkg.NewFloat64(...)`).
+func isWrapperCall(call *ast.CallExpr, name string) bool {
+	switch fn := call.Fun.(type) {
+	case *ast.SelectorExpr:
+		return fn.Sel.Name == name
+	case *ast.Ident:
+		return fn.Name == name
+	}
+	return false
+}
+
+// inferPropertyType guesses the schema type of a property value expression
+// without full type information, mirroring the heuristics the analyzer uses
+// for literal property maps today.
+func inferPropertyType(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		switch e.Kind.String() {
+		case "STRING":
+			return "string"
+		case "INT", "FLOAT":
+			return "number"
+		}
+	case *ast.Ident:
+		if e.Name == "true" || e.Name == "false" {
+			return "boolean"
+		}
+	case *ast.CompositeLit:
+		switch e.Type.(type) {
+		case *ast.ArrayType:
+			return "array"
+		default:
+			return "object"
+		}
+	case *ast.CallExpr:
+		switch {
+		case isWrapperCall(e, "NewString"):
+			return "string"
+		case isWrapperCall(e, "NewFloat64"), isWrapperCall(e, "NewInt64"):
+			return "number"
+		case isWrapperCall(e, "NewBool"):
+			return "boolean"
+		}
+	case *ast.UnaryExpr:
+		return inferPropertyType(e.X)
+	}
+	return "unknown"
+}
+
+// propertiesFromMapLiteral extracts a property schema from a map composite
+// literal such as map[string]any{"plan": "premium"} or a named map type with
+// the same literal shape (e.g. mixpanel.PeopleProperties{...}).
+func propertiesFromMapLiteral(expr ast.Expr) map[string]Property {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+	props := map[string]Property{}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := stringLiteral(kv.Key)
+		if !ok {
+			continue
+		}
+		props[key] = Property{Type: inferPropertyType(kv.Value)}
+	}
+	return props
+}
+
+// builderChainCalls walks a fluent builder chain like
+// analytics.NewProperties().Set("a", 1).Set("b", 2) and returns the ordered
+// list of CallExprs for every .Set(...)-style call found, innermost first.
+func builderChainCalls(expr ast.Expr, method string) []*ast.CallExpr {
+	var calls []*ast.CallExpr
+	for {
+		call, ok := expr.(*ast.CallExpr)
+		if !ok {
+			break
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			break
+		}
+		if sel.Sel.Name == method {
+			calls = append([]*ast.CallExpr{call}, calls...)
+		}
+		expr = sel.X
+	}
+	return calls
+}
+
+// propertiesFromBuilderChain extracts a property schema from a chain of
+// two-argument `.Set(key, value)` calls, as used by Segment's
+// analytics.NewProperties()/NewTraits() and PostHog's posthog.NewProperties().
+func propertiesFromBuilderChain(expr ast.Expr) map[string]Property {
+	calls := builderChainCalls(expr, "Set")
+	if len(calls) == 0 {
+		return nil
+	}
+	props := map[string]Property{}
+	for _, call := range calls {
+		if len(call.Args) != 2 {
+			continue
+		}
+		key, ok := stringLiteral(call.Args[0])
+		if !ok {
+			continue
+		}
+		props[key] = Property{Type: inferPropertyType(call.Args[1])}
+	}
+	return props
+}
+
+// identifierName returns the plain identifier name of expr, if it is one.
+func identifierName(expr ast.Expr) (string, bool) {
+	if id, ok := expr.(*ast.Ident); ok {
+		return id.Name, true
+	}
+	return "", false
+}
+
+// selector splits a `pkg.Sel` or `recv.Sel` expression into its parts.
+func selector(expr ast.Expr) (x ast.Expr, selName string, ok bool) {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return nil, "", false
+	}
+	return sel.X, sel.Sel.Name, true
+}
+
+// isCallTo reports whether call invokes `pkgOrRecv.method`, where pkgOrRecv
+// is a bare identifier matching pkgOrRecvName.
+func isCallTo(call *ast.CallExpr, pkgOrRecvName, method string) bool {
+	x, sel, ok := selector(call.Fun)
+	if !ok || sel != method {
+		return false
+	}
+	name, ok := identifierName(x)
+	return ok && name == pkgOrRecvName
+}
+
+// isEmptyStringLiteral reports whether expr resolves to an empty or
+// whitespace-only string literal, used by identity classification
+// (anonymous IDs passed as "").
+func isEmptyStringLiteral(expr ast.Expr) bool {
+	v, ok := stringLiteral(expr)
+	return ok && strings.TrimSpace(v) == ""
+}
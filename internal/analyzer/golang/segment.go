@@ -0,0 +1,116 @@
+package golang
+
+import "go/ast"
+
+// segmentKinds maps Segment's analytics.* message struct names to the
+// normalized Event.Kind the analyzer reports for them.
+var segmentKinds = map[string]string{
+	"Track":    "track",
+	"Identify": "identify",
+	"Group":    "group",
+	"Page":     "page",
+	"Screen":   "screen",
+	"Alias":    "alias",
+}
+
+// AnalyzeSegment walks file looking for client.Enqueue(analytics.<Message>{...})
+// calls and classifies each by its concrete Segment spec message type.
+func AnalyzeSegment(file *ast.File) []Event {
+	var events []Event
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 {
+			return true
+		}
+		if _, method, ok := selector(call.Fun); !ok || method != "Enqueue" {
+			return true
+		}
+		if ev, ok := segmentEventFromMessage(call.Args[0]); ok {
+			events = append(events, ev)
+		}
+		return true
+	})
+	return events
+}
+
+func segmentEventFromMessage(expr ast.Expr) (Event, bool) {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return Event{}, false
+	}
+	sel, ok := lit.Type.(*ast.SelectorExpr)
+	if !ok {
+		return Event{}, false
+	}
+	kind, ok := segmentKinds[sel.Sel.Name]
+	if !ok {
+		return Event{}, false
+	}
+
+	ev := Event{Provider: "segment", Kind: kind, Attributes: map[string]string{}}
+	var hasUserId, hasAnonymousId bool
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		field, ok := identifierName(kv.Key)
+		if !ok {
+			continue
+		}
+		switch field {
+		case "Event":
+			if name, ok := stringLiteral(kv.Value); ok {
+				ev.EventName = name
+			}
+		case "Name":
+			if name, ok := stringLiteral(kv.Value); ok {
+				ev.EventName = name
+			}
+		case "UserId":
+			hasUserId = !isEmptyStringLiteral(kv.Value)
+			if v, ok := stringLiteral(kv.Value); ok && v != "" {
+				ev.Attributes["UserId"] = v
+			}
+		case "AnonymousId":
+			hasAnonymousId = !isEmptyStringLiteral(kv.Value)
+			if v, ok := stringLiteral(kv.Value); ok && v != "" {
+				ev.Attributes["AnonymousId"] = v
+			}
+		case "GroupId":
+			if v, ok := stringLiteral(kv.Value); ok && v != "" {
+				ev.Attributes["GroupId"] = v
+			}
+		case "PreviousId":
+			if v, ok := stringLiteral(kv.Value); ok && v != "" {
+				ev.Attributes["PreviousId"] = v
+			}
+		case "Properties", "Traits":
+			props := propertiesFromBuilderChain(kv.Value)
+			if props == nil {
+				props = propertiesFromMapLiteral(kv.Value)
+			}
+			if props != nil {
+				ev.Properties = props
+			}
+		}
+	}
+
+	if kind == "alias" && ev.EventName == "" {
+		ev.EventName = "$alias"
+	} else if ev.EventName == "" {
+		ev.EventName = "$" + kind
+	}
+
+	switch {
+	case hasUserId:
+		ev.Identity = IdentityIdentified
+	case hasAnonymousId:
+		ev.Identity = IdentityAnonymous
+	}
+
+	if len(ev.Attributes) == 0 {
+		ev.Attributes = nil
+	}
+	return ev, true
+}
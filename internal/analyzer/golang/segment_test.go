@@ -0,0 +1,82 @@
+package golang
+
+import "testing"
+
+const segmentSrc = `
+package main
+
+import "github.com/segmentio/analytics-go/v3"
+
+func segmentSpecTrack(userId string) {
+	client := analytics.New("key")
+	client.Enqueue(analytics.Identify{
+		UserId: userId,
+		Traits: analytics.NewTraits().
+			Set("plan", "Enterprise").
+			Set("email", "user@example.com"),
+	})
+	client.Enqueue(analytics.Group{
+		UserId:  userId,
+		GroupId: "initech",
+		Traits: analytics.NewTraits().
+			Set("name", "Initech"),
+	})
+	client.Enqueue(analytics.Page{
+		UserId: userId,
+		Name:   "Home",
+		Properties: analytics.NewProperties().
+			Set("url", "https://example.com"),
+	})
+	client.Enqueue(analytics.Screen{
+		UserId: userId,
+		Name:   "Checkout",
+		Properties: analytics.NewProperties().
+			Set("step", 2),
+	})
+	client.Enqueue(analytics.Alias{
+		UserId:     userId,
+		PreviousId: "anonymous-id",
+	})
+	client.Enqueue(analytics.Page{
+		AnonymousId: "visitor-123",
+		Name:        "Pricing",
+	})
+}
+`
+
+func TestAnalyzeSegment(t *testing.T) {
+	file := parseFile(t, segmentSrc)
+	events := AnalyzeSegment(file)
+
+	wantKinds := []string{"identify", "group", "page", "screen", "alias", "page"}
+	if len(events) != len(wantKinds) {
+		t.Fatalf("got %d events, want %d: %+v", len(events), len(wantKinds), events)
+	}
+	for i, k := range wantKinds {
+		if events[i].Kind != k {
+			t.Errorf("event %d kind = %q, want %q", i, events[i].Kind, k)
+		}
+		if events[i].Provider != "segment" {
+			t.Errorf("event %d provider = %q, want segment", i, events[i].Provider)
+		}
+	}
+
+	if got := events[0].Properties["plan"]; got != (Property{Type: "string"}) {
+		t.Errorf("identify plan property = %+v", got)
+	}
+	if got := events[1].Attributes["GroupId"]; got == "" {
+		t.Errorf("group event missing GroupId attribute: %+v", events[1])
+	}
+	if got := events[2].EventName; got != "Home" {
+		t.Errorf("page event name = %q, want Home", got)
+	}
+	if got := events[4].Attributes["PreviousId"]; got != "anonymous-id" {
+		t.Errorf("alias event PreviousId = %q, want anonymous-id", got)
+	}
+	if got := events[0].Identity; got != IdentityIdentified {
+		t.Errorf("identify event Identity = %q, want %q", got, IdentityIdentified)
+	}
+	if got := events[5].Identity; got != IdentityAnonymous {
+		t.Errorf("anonymous-id-only page event Identity = %q, want %q", got, IdentityAnonymous)
+	}
+}
@@ -0,0 +1,176 @@
+package golang
+
+import "go/ast"
+
+// snowplowTrackMethods maps the Snowplow tracker methods this detector
+// recognizes to a handler that turns the call's single event-literal
+// argument into an Event.
+var snowplowTrackMethods = map[string]func(*ast.CompositeLit) (Event, bool){
+	"TrackStructEvent":          snowplowStructEvent,
+	"TrackSelfDescribingEvent":  snowplowSelfDescribingEvent,
+	"TrackPageView":             snowplowPageViewEvent,
+	"TrackScreenView":           snowplowScreenViewEvent,
+	"TrackTiming":               snowplowTimingEvent,
+	"TrackEcommerceTransaction": snowplowEcommerceEvent,
+}
+
+// AnalyzeSnowplow walks file looking for Snowplow tracker.Track*(...) calls:
+// structured events, self-describing events, page/screen views, timing, and
+// ecommerce transactions.
+func AnalyzeSnowplow(file *ast.File) []Event {
+	var events []Event
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 {
+			return true
+		}
+		_, method, ok := selector(call.Fun)
+		if !ok {
+			return true
+		}
+		handler, ok := snowplowTrackMethods[method]
+		if !ok {
+			return true
+		}
+		lit, ok := call.Args[0].(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		if ev, ok := handler(lit); ok {
+			events = append(events, ev)
+		}
+		return true
+	})
+	return events
+}
+
+// structFieldValue returns the KeyValueExpr.Value for field in lit, if present.
+func structFieldValue(lit *ast.CompositeLit, field string) (ast.Expr, bool) {
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if name, ok := identifierName(kv.Key); ok && name == field {
+			return kv.Value, true
+		}
+	}
+	return nil, false
+}
+
+// liftFields resolves each field in fieldToKey present on lit (following
+// sp.NewString/NewFloat64/NewInt64 wrappers) into a property keyed by its
+// mapped name.
+func liftFields(lit *ast.CompositeLit, fieldToKey map[string]string) map[string]Property {
+	props := map[string]Property{}
+	for field, key := range fieldToKey {
+		val, ok := structFieldValue(lit, field)
+		if !ok {
+			continue
+		}
+		props[key] = Property{Type: inferPropertyType(val)}
+	}
+	if len(props) == 0 {
+		return nil
+	}
+	return props
+}
+
+func snowplowStructEvent(lit *ast.CompositeLit) (Event, bool) {
+	actionVal, ok := structFieldValue(lit, "Action")
+	if !ok {
+		return Event{}, false
+	}
+	name, ok := stringLiteral(actionVal)
+	if !ok {
+		return Event{}, false
+	}
+	return Event{
+		Provider:  "snowplow",
+		Kind:      "struct",
+		EventName: name,
+		Properties: liftFields(lit, map[string]string{
+			"Category": "category",
+			"Property": "property",
+			"Value":    "value",
+			"Label":    "label",
+		}),
+	}, true
+}
+
+// snowplowSelfDescribingEvent handles
+// sp.SelfDescribingEvent{Event: sp.InitSelfDescribingJson(schemaURI, data)}.
+func snowplowSelfDescribingEvent(lit *ast.CompositeLit) (Event, bool) {
+	eventVal, ok := structFieldValue(lit, "Event")
+	if !ok {
+		return Event{}, false
+	}
+	call, ok := eventVal.(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 {
+		return Event{}, false
+	}
+	if _, sel, ok := selector(call.Fun); !ok || sel != "InitSelfDescribingJson" {
+		return Event{}, false
+	}
+	schemaURI, ok := stringLiteral(call.Args[0])
+	if !ok {
+		return Event{}, false
+	}
+	return Event{
+		Provider:   "snowplow",
+		Kind:       "self_describing",
+		EventName:  schemaURI,
+		Properties: propertiesFromMapLiteral(call.Args[1]),
+	}, true
+}
+
+func snowplowPageViewEvent(lit *ast.CompositeLit) (Event, bool) {
+	return Event{
+		Provider:  "snowplow",
+		Kind:      "page_view",
+		EventName: "page_view",
+		Properties: liftFields(lit, map[string]string{
+			"PageUrl":   "page_url",
+			"PageTitle": "page_title",
+		}),
+	}, true
+}
+
+func snowplowScreenViewEvent(lit *ast.CompositeLit) (Event, bool) {
+	return Event{
+		Provider:  "snowplow",
+		Kind:      "screen_view",
+		EventName: "screen_view",
+		Properties: liftFields(lit, map[string]string{
+			"Name": "name",
+			"Id":   "id",
+		}),
+	}, true
+}
+
+func snowplowTimingEvent(lit *ast.CompositeLit) (Event, bool) {
+	return Event{
+		Provider:  "snowplow",
+		Kind:      "timing",
+		EventName: "timing",
+		Properties: liftFields(lit, map[string]string{
+			"Category": "category",
+			"Variable": "variable",
+			"Label":    "label",
+			"Timing":   "timing",
+		}),
+	}, true
+}
+
+func snowplowEcommerceEvent(lit *ast.CompositeLit) (Event, bool) {
+	return Event{
+		Provider:  "snowplow",
+		Kind:      "ecommerce_transaction",
+		EventName: "ecommerce_transaction",
+		Properties: liftFields(lit, map[string]string{
+			"OrderId":    "order_id",
+			"TotalValue": "total_value",
+			"Currency":   "currency",
+		}),
+	}, true
+}
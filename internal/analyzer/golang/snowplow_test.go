@@ -0,0 +1,109 @@
+package golang
+
+import "testing"
+
+const snowplowSrc = `
+package main
+
+import sp "github.com/snowplow/snowplow-golang-tracker/tracker"
+
+func snowplowAdvancedTrack(pageUrl string, screenName string) {
+	tracker.TrackSelfDescribingEvent(sp.SelfDescribingEvent{
+		Event: sp.InitSelfDescribingJson(
+			"iglu:com.acme/link_click/jsonschema/1-0-0",
+			map[string]any{
+				"target_url": "https://example.com/product",
+			},
+		),
+	})
+	tracker.TrackPageView(sp.PageViewEvent{
+		PageUrl:   sp.NewString(pageUrl),
+		PageTitle: sp.NewString("Home"),
+	})
+	tracker.TrackScreenView(sp.ScreenViewEvent{
+		Name: sp.NewString(screenName),
+		Id:   sp.NewString("screen-1"),
+	})
+	tracker.TrackTiming(sp.TimingEvent{
+		Category: sp.NewString("render"),
+		Variable: sp.NewString("homepage"),
+		Timing:   sp.NewInt64(120),
+	})
+	tracker.TrackEcommerceTransaction(sp.EcommerceTransactionEvent{
+		OrderId:    sp.NewString("order-1"),
+		TotalValue: sp.NewFloat64(42.5),
+	})
+}
+`
+
+func TestAnalyzeSnowplow(t *testing.T) {
+	file := parseFile(t, snowplowSrc)
+	events := AnalyzeSnowplow(file)
+
+	wantKinds := []string{"self_describing", "page_view", "screen_view", "timing", "ecommerce_transaction"}
+	if len(events) != len(wantKinds) {
+		t.Fatalf("got %d events, want %d: %+v", len(events), len(wantKinds), events)
+	}
+	for i, k := range wantKinds {
+		if events[i].Kind != k {
+			t.Errorf("event %d kind = %q, want %q", i, events[i].Kind, k)
+		}
+	}
+
+	if got := events[0].EventName; got != "iglu:com.acme/link_click/jsonschema/1-0-0" {
+		t.Errorf("self-describing event name = %q", got)
+	}
+	if got := events[0].Properties["target_url"]; got.Type != "string" {
+		t.Errorf("self-describing target_url property = %+v", got)
+	}
+	if got := events[1].Properties["page_title"]; got.Type != "string" {
+		t.Errorf("page view should resolve wrapped sp.NewString, got %+v", got)
+	}
+	if got := events[3].Properties["timing"]; got.Type != "number" {
+		t.Errorf("timing value should resolve wrapped sp.NewInt64 as number, got %+v", got)
+	}
+	if got := events[4].Properties["total_value"]; got.Type != "number" {
+		t.Errorf("ecommerce total_value should resolve wrapped sp.NewFloat64 as number, got %+v", got)
+	}
+}
+
+const snowplowStructEventSrc = `
+package main
+
+import sp "github.com/snowplow/snowplow-golang-tracker/tracker"
+
+func snowplowTrack(property string, value float64) {
+	tracker.TrackStructEvent(sp.StructuredEvent{
+		Action:   sp.NewString("add-to-basket"),
+		Category: sp.NewString("test"),
+		Property: sp.NewString(property),
+		Value:    sp.NewFloat64(value),
+	})
+}
+`
+
+func TestAnalyzeSnowplowStructEvent(t *testing.T) {
+	file := parseFile(t, snowplowStructEventSrc)
+	events := AnalyzeSnowplow(file)
+
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1: %+v", len(events), events)
+	}
+
+	ev := events[0]
+	if ev.Kind != "struct" {
+		t.Errorf("kind = %q, want struct", ev.Kind)
+	}
+	if ev.EventName != "add-to-basket" {
+		t.Errorf("event name = %q, want add-to-basket", ev.EventName)
+	}
+	if got := ev.Properties["category"]; got.Type != "string" {
+		t.Errorf("category property = %+v, want string", got)
+	}
+	if got := ev.Properties["property"]; got.Type != "string" {
+		t.Errorf("property property should resolve wrapped sp.NewString as string, got %+v", got)
+	}
+	if got := ev.Properties["value"]; got.Type != "number" {
+		t.Errorf("value property should resolve wrapped sp.NewFloat64 as number, got %+v", got)
+	}
+}
@@ -1,3 +1,8 @@
+//go:build ignore
+
+// This file is a sample-code fixture for the provider detectors under
+// internal/analyzer/golang; it intentionally imports third-party SDKs that
+// are not module dependencies, so it is excluded from normal builds.
 package main
 
 import (
@@ -5,6 +10,9 @@ import (
 	"log"
 
 	"github.com/amplitude/analytics-go/amplitude"
+	"github.com/customerio/go-customerio"
+	"github.com/foomo/sesamy-go/measurementprotocol/v2"
+	"github.com/foomo/sesamy-go/measurementprotocol/v2/event"
 	"github.com/mixpanel/mixpanel-go"
 	"github.com/posthog/posthog-go"
 	"github.com/segmentio/analytics-go/v3"
@@ -27,6 +35,38 @@ func segmentTrack(userId string) {
 	})
 }
 
+func segmentSpecTrack(userId string, groupId string) {
+	client := analytics.New("YOUR_SEGMENT_WRITE_KEY")
+	client.Enqueue(analytics.Identify{
+		UserId: userId,
+		Traits: analytics.NewTraits().
+			Set("plan", "Enterprise").
+			Set("email", "user@example.com"),
+	})
+	client.Enqueue(analytics.Group{
+		UserId:  userId,
+		GroupId: groupId,
+		Traits: analytics.NewTraits().
+			Set("name", "Initech"),
+	})
+	client.Enqueue(analytics.Page{
+		UserId: userId,
+		Name:   "Home",
+		Properties: analytics.NewProperties().
+			Set("url", "https://example.com"),
+	})
+	client.Enqueue(analytics.Screen{
+		UserId: userId,
+		Name:   "Checkout",
+		Properties: analytics.NewProperties().
+			Set("step", 2),
+	})
+	client.Enqueue(analytics.Alias{
+		UserId:     userId,
+		PreviousId: "anonymous-id",
+	})
+}
+
 func mixpanelTrack(userId string, price float64) {
 	mp := mixpanel.NewApiClient("YOUR_MIXPANEL_TOKEN")
 	ctx := context.Background()
@@ -38,6 +78,31 @@ func mixpanelTrack(userId string, price float64) {
 	})
 }
 
+func mixpanelImportTrack(userId string, price float64) {
+	mp := mixpanel.NewApiClient("YOUR_MIXPANEL_TOKEN")
+	ctx := context.Background()
+	mp.Import(ctx, []*mixpanel.Event{
+		mp.NewEvent("historical_purchase", userId, map[string]any{
+			"plan":  "premium",
+			"price": price,
+		}),
+	})
+}
+
+func mixpanelPeopleTrack(userId string, plan string) {
+	mp := mixpanel.NewApiClient("YOUR_MIXPANEL_TOKEN")
+	ctx := context.Background()
+	people := mp.Identify(userId)
+	people.Update(ctx, mixpanel.PeopleProperties{
+		"$set": map[string]any{
+			"plan": plan,
+		},
+	})
+	people.Track(ctx, "Upgraded Plan", map[string]any{
+		"plan": plan,
+	})
+}
+
 func amplitudeTrack(isFreeTrial bool) {
 	config := amplitude.NewConfig("YOUR_AMPLITUDE_API_KEY")
 	client := amplitude.NewClient(config)
@@ -55,6 +120,33 @@ func amplitudeTrack(isFreeTrial bool) {
 	})
 }
 
+func amplitudeIdentifyTrack(userId string, plan string, groupName string, price float64) {
+	config := amplitude.NewConfig("YOUR_AMPLITUDE_API_KEY")
+	client := amplitude.NewClient(config)
+
+	identify := amplitude.Identify{}
+	identify.Set("plan", plan)
+	identify.SetOnce("first_seen", "2024-01-01")
+	identify.Add("login_count", 1)
+	identify.Append("history", "upgraded")
+	client.Identify(identify, amplitude.EventOptions{
+		UserID: userId,
+	})
+
+	client.GroupIdentify("org", groupName, amplitude.Identify{}, amplitude.EventOptions{
+		UserID: userId,
+	})
+
+	client.Revenue(amplitude.Revenue{
+		ProductID:   "sku-1",
+		Price:       price,
+		Quantity:    1,
+		RevenueType: "purchase",
+	}, amplitude.EventOptions{
+		UserID: userId,
+	})
+}
+
 func posthogTrack(plan string, isFreeTrial bool) {
 	client, err := posthog.NewWithConfig("YOUR_POSTHOG_API_KEY", posthog.Config{})
 	if err != nil {
@@ -86,12 +178,85 @@ func snowplowTrack(property string, value float64) {
 	})
 }
 
+func snowplowAdvancedTrack(pageUrl string, screenName string) {
+	emitter := sp.InitEmitter(
+		sp.RequireCollectorUri("collector.example.com"),
+	)
+	tracker := sp.InitTracker(
+		sp.RequireEmitter(emitter),
+	)
+	tracker.TrackSelfDescribingEvent(sp.SelfDescribingEvent{
+		Event: sp.InitSelfDescribingJson(
+			"iglu:com.acme/link_click/jsonschema/1-0-0",
+			map[string]any{
+				"target_url": pageUrl,
+			},
+		),
+	})
+	tracker.TrackPageView(sp.PageViewEvent{
+		PageUrl:   sp.NewString(pageUrl),
+		PageTitle: sp.NewString("Home"),
+	})
+	tracker.TrackScreenView(sp.ScreenViewEvent{
+		Name: sp.NewString(screenName),
+		Id:   sp.NewString("screen-1"),
+	})
+	tracker.TrackTiming(sp.TimingEvent{
+		Category: sp.NewString("render"),
+		Variable: sp.NewString("homepage"),
+		Timing:   sp.NewInt64(120),
+	})
+	tracker.TrackEcommerceTransaction(sp.EcommerceTransactionEvent{
+		OrderId:    sp.NewString("order-1"),
+		TotalValue: sp.NewFloat64(42.5),
+	})
+}
+
+func ga4Track(clientId string, transactionId string, price float64) {
+	client := measurementprotocol.NewClient("YOUR_GA4_MEASUREMENT_ID", "YOUR_GA4_API_SECRET")
+	client.Send(clientId, measurementprotocol.Event{
+		Name: "begin_checkout",
+		Params: map[string]any{
+			"currency": "USD",
+			"value":    price,
+		},
+	})
+	client.Send(clientId, event.Purchase{
+		TransactionId: transactionId,
+		Currency:      "USD",
+		Value:         price,
+		Items: []event.Item{
+			{ItemId: "sku-1", ItemName: "Widget", Price: price},
+		},
+	}.ToEvent())
+}
+
+func customerioTrack(customerID string, anonymousID string, plan string) {
+	client := customerio.NewTrackClient("YOUR_SITE_ID", "YOUR_API_KEY")
+	client.Identify(customerID, map[string]any{
+		"plan": plan,
+	})
+	client.Track(customerID, "Upgraded Plan", map[string]any{
+		"plan": plan,
+	})
+	client.TrackAnonymous(anonymousID, "Viewed Pricing Page", map[string]any{
+		"plan": plan,
+	})
+}
+
 func main() {
 	segmentTrack("f4ca124298")
+	segmentSpecTrack("f4ca124298", "initech")
 	mixpanelTrack("f4ca124298", 1.99)
+	mixpanelImportTrack("f4ca124298", 1.99)
+	mixpanelPeopleTrack("f4ca124298", "Enterprise")
 	amplitudeTrack(false)
+	amplitudeIdentifyTrack("f4ca124298", "Enterprise", "Initech", 1.99)
 	posthogTrack("Enterprise", false)
 	snowplowTrack("pcs", 2)
+	snowplowAdvancedTrack("https://example.com/home", "checkout")
+	ga4Track("client-id-123", "txn-1", 42.5)
+	customerioTrack("f4ca124298", "anon-9f8c", "Enterprise")
 
 	// Custom function usage
 	var baz int = 42